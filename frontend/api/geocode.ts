@@ -1,5 +1,87 @@
 import type { VercelRequest, VercelResponse } from '@vercel/node';
 
+// Responses are cached per warm function instance to cut Geoapify usage
+const CACHE_TTL_MS = 24 * 60 * 60 * 1000;
+const CACHE_MAX_ENTRIES = 500;
+
+// Per-client request budgets, also per warm instance. Live tracking reverse
+// lookups get their own budget so they can't starve address autocomplete.
+const RATE_LIMIT_WINDOW_MS = 60 * 1000;
+const RATE_LIMIT_MAX_REQUESTS = {
+  autocomplete: 60,
+  reverse: 120,
+};
+const RATE_LIMIT_MAX_CLIENTS = 1000;
+
+type LookupKind = keyof typeof RATE_LIMIT_MAX_REQUESTS;
+
+const cache = new Map<string, { data: unknown; expiresAt: number }>();
+const requestCounts = new Map<string, { count: number; windowStart: number }>();
+
+function getClientIp(req: VercelRequest): string {
+  const forwarded = req.headers['x-forwarded-for'];
+  const value = Array.isArray(forwarded) ? forwarded[0] : forwarded;
+  return value?.split(',')[0].trim() || req.socket?.remoteAddress || 'unknown';
+}
+
+function pruneRequestCounts(now: number) {
+  for (const [key, entry] of requestCounts) {
+    if (now - entry.windowStart > RATE_LIMIT_WINDOW_MS) {
+      requestCounts.delete(key);
+    }
+  }
+
+  // Still full of live windows: drop the oldest (Map preserves insertion order)
+  while (requestCounts.size >= RATE_LIMIT_MAX_CLIENTS) {
+    const oldestKey = requestCounts.keys().next().value;
+    if (oldestKey === undefined) break;
+    requestCounts.delete(oldestKey);
+  }
+}
+
+function isRateLimited(clientIp: string, kind: LookupKind): boolean {
+  const now = Date.now();
+  const key = `${kind}:${clientIp}`;
+  const entry = requestCounts.get(key);
+
+  if (!entry || now - entry.windowStart > RATE_LIMIT_WINDOW_MS) {
+    // Re-insert so the map stays ordered by window start
+    requestCounts.delete(key);
+    pruneRequestCounts(now);
+    requestCounts.set(key, { count: 1, windowStart: now });
+    return false;
+  }
+
+  entry.count++;
+  return entry.count > RATE_LIMIT_MAX_REQUESTS[kind];
+}
+
+function getCached(key: string): unknown | null {
+  const entry = cache.get(key);
+  if (!entry) return null;
+
+  if (entry.expiresAt < Date.now()) {
+    cache.delete(key);
+    return null;
+  }
+
+  return entry.data;
+}
+
+function setCached(key: string, data: unknown) {
+  // Evict the oldest entry once full (Map preserves insertion order)
+  if (cache.size >= CACHE_MAX_ENTRIES) {
+    const oldestKey = cache.keys().next().value;
+    if (oldestKey !== undefined) cache.delete(oldestKey);
+  }
+
+  cache.set(key, { data, expiresAt: Date.now() + CACHE_TTL_MS });
+}
+
+function isValidCoordinate(value: unknown, limit: number): value is number {
+  return typeof value === 'number' && Number.isFinite(value) && Math.abs(value) <= limit;
+}
+
 export default async function handler(
   req: VercelRequest,
   res: VercelResponse
@@ -9,12 +91,34 @@ export default async function handler(
     return res.status(405).json({ error: 'Method not allowed' });
   }
 
-  const { text } = req.body;
+  const { text, lat, lng } = req.body || {};
+  const isReverse = lat !== undefined || lng !== undefined;
 
-  if (!text || typeof text !== 'string') {
+  if (isReverse) {
+    if (!isValidCoordinate(lat, 90) || !isValidCoordinate(lng, 180)) {
+      return res.status(400).json({ error: 'Valid lat and lng parameters are required' });
+    }
+  } else if (!text || typeof text !== 'string') {
     return res.status(400).json({ error: 'Text parameter is required' });
   }
 
+  // ~1m precision is plenty for an address and lets nearby fixes share entries
+  const cacheKey = isReverse
+    ? `reverse:${lat.toFixed(5)},${lng.toFixed(5)}`
+    : `autocomplete:${text.trim().toLowerCase()}`;
+
+  // Cache hits cost nothing upstream, so they don't count against the budget
+  const cached = getCached(cacheKey);
+  if (cached) {
+    res.setHeader('X-Cache', 'HIT');
+    return res.status(200).json(cached);
+  }
+
+  if (isRateLimited(getClientIp(req), isReverse ? 'reverse' : 'autocomplete')) {
+    res.setHeader('Retry-After', String(RATE_LIMIT_WINDOW_MS / 1000));
+    return res.status(429).json({ error: 'Too many requests' });
+  }
+
   // Get API key from environment (not exposed to client)
   const apiKey = process.env.GEOAPIFY_API_KEY;
 
@@ -23,14 +127,26 @@ export default async function handler(
   }
 
   try {
-    // Call Geoapify autocomplete API
-    const url = new URL('https://api.geoapify.com/v1/geocode/autocomplete');
-    url.searchParams.append('text', text);
-    url.searchParams.append('apiKey', apiKey);
-    url.searchParams.append('filter', 'countrycode:za'); // South Africa only
-    url.searchParams.append('limit', '5');
-    url.searchParams.append('type', 'amenity');
-    url.searchParams.append('lang', 'en');
+    let url: URL;
+
+    if (isReverse) {
+      // Call Geoapify reverse geocoding API
+      url = new URL('https://api.geoapify.com/v1/geocode/reverse');
+      url.searchParams.append('lat', String(lat));
+      url.searchParams.append('lon', String(lng));
+      url.searchParams.append('apiKey', apiKey);
+      url.searchParams.append('limit', '1');
+      url.searchParams.append('lang', 'en');
+    } else {
+      // Call Geoapify autocomplete API
+      url = new URL('https://api.geoapify.com/v1/geocode/autocomplete');
+      url.searchParams.append('text', text);
+      url.searchParams.append('apiKey', apiKey);
+      url.searchParams.append('filter', 'countrycode:za'); // South Africa only
+      url.searchParams.append('limit', '5');
+      url.searchParams.append('type', 'amenity');
+      url.searchParams.append('lang', 'en');
+    }
 
     const response = await fetch(url.toString());
 
@@ -39,11 +155,15 @@ export default async function handler(
     }
 
     const data = await response.json();
+    setCached(cacheKey, data);
 
     // Return results
+    res.setHeader('X-Cache', 'MISS');
     return res.status(200).json(data);
   } catch (error) {
     console.error('Geocoding error:', error);
-    return res.status(500).json({ error: 'Failed to fetch address suggestions' });
+    return res.status(500).json({
+      error: isReverse ? 'Failed to resolve address' : 'Failed to fetch address suggestions',
+    });
   }
 }
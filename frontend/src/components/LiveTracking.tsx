@@ -9,6 +9,8 @@ import {
 } from "lucide-preact";
 import { LoadingSpinner } from "./LoadingSpinner";
 import { trackneticsService } from "../services/tracknetics";
+import { safeAddressService } from "../services/safeAddresses";
+import { sanitizeText } from "../utils/validation";
 import type { Safe } from "../types";
 import { formatDistanceToNow } from "date-fns";
 import L from "leaflet";
@@ -52,6 +54,9 @@ export function LiveTracking({ safes }: LiveTrackingProps) {
   const mapRef = useRef<HTMLDivElement>(null);
   const leafletMapRef = useRef<L.Map | null>(null);
   const markersRef = useRef<L.Marker[]>([]);
+  // Kept apart from locations so an arriving address doesn't rebuild markers
+  const [addresses, setAddresses] = useState<Map<string, string>>(new Map());
+  const addressesRef = useRef<Map<string, string>>(new Map());
   const [mapsLoaded, setMapsLoaded] = useState(false);
 
   // Initialize Leaflet map
@@ -129,8 +134,10 @@ export function LiveTracking({ safes }: LiveTrackingProps) {
           leafletMapRef.current!
         );
 
-        // Create popup
-        const popupContent = `
+        // Create popup (built on open so it picks up the latest address)
+        const popupContent = () => {
+          const address = addressesRef.current.get(safeLocation.safeId);
+          return `
         <div style="padding: 8px; min-width: 200px;">
           <h3 style="margin: 0 0 8px 0; font-size: 16px; font-weight: 600;">
             Safe ${safeLocation.serialNumber}
@@ -139,9 +146,11 @@ export function LiveTracking({ safes }: LiveTrackingProps) {
             <p style="margin: 4px 0;"><strong>Status:</strong> ${getStatusLabel(
               safeLocation.status
             )}</p>
-            <p style="margin: 4px 0;"><strong>Location:</strong> ${position[0].toFixed(
-              6
-            )}, ${position[1].toFixed(6)}</p>
+            <p style="margin: 4px 0;"><strong>Location:</strong> ${
+              address
+                ? sanitizeText(address)
+                : `${position[0].toFixed(6)}, ${position[1].toFixed(6)}`
+            }</p>
             <p style="margin: 4px 0;"><strong>Accuracy:</strong> ±${
               safeLocation.location.accuracy
             }m</p>
@@ -156,6 +165,7 @@ export function LiveTracking({ safes }: LiveTrackingProps) {
           </div>
         </div>
       `;
+        };
 
         marker.bindPopup(popupContent);
         markersRef.current.push(marker);
@@ -274,6 +284,37 @@ export function LiveTracking({ safes }: LiveTrackingProps) {
     setLocations([...newLocations]);
     setLastUpdate(new Date());
     setLoading(false);
+
+    // Plot positions first, then fill in addresses without blocking the map
+    resolveAddresses(newLocations);
+  };
+
+  const resolveAddresses = async (pending: SafeLocationData[]) => {
+    const resolved = await Promise.all(
+      pending.map(async (safeLocation) => {
+        if (!safeLocation.location) return null;
+        const address = await safeAddressService.resolve(
+          safeLocation.safeId,
+          safeLocation.location.lat,
+          safeLocation.location.lng
+        );
+        return address ? { safeId: safeLocation.safeId, address } : null;
+      })
+    );
+
+    const next = new Map(addressesRef.current);
+    let changed = false;
+    for (const entry of resolved) {
+      if (entry && next.get(entry.safeId) !== entry.address) {
+        next.set(entry.safeId, entry.address);
+        changed = true;
+      }
+    }
+
+    if (changed) {
+      addressesRef.current = next;
+      setAddresses(next);
+    }
   };
 
   // Auto-refresh every 30 seconds | 15 seconds for active trips
@@ -496,6 +537,15 @@ export function LiveTracking({ safes }: LiveTrackingProps) {
 
               {safeLocation.location ? (
                 <div className="space-y-3">
+                  {addresses.get(safeLocation.safeId) && (
+                    <div className="text-sm">
+                      <p className="text-gray-500">Address</p>
+                      <p className="text-gray-900">
+                        {addresses.get(safeLocation.safeId)}
+                      </p>
+                    </div>
+                  )}
+
                   <div className="grid grid-cols-2 gap-4 text-sm">
                     <div>
                       <p className="text-gray-500">Latitude</p>
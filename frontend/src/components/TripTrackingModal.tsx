@@ -12,6 +12,8 @@ import {
 } from "lucide-preact";
 import { LoadingSpinner } from "./LoadingSpinner";
 import { trackneticsService } from "../services/tracknetics";
+import { safeAddressService } from "../services/safeAddresses";
+import { sanitizeText } from "../utils/validation";
 import { safes } from "../store/data";
 import { formatDistanceToNow, format } from "date-fns";
 import L from "leaflet";
@@ -62,6 +64,10 @@ export function TripTrackingModal({ trip, onClose }: TripTrackingModalProps) {
   const mapRef = useRef<HTMLDivElement>(null);
   const leafletMapRef = useRef<L.Map | null>(null);
   const safeMarkerRef = useRef<L.Marker | null>(null);
+  const [address, setAddress] = useState<string | null>(() =>
+    safeAddressService.getLastAddress(trip.safe_id)
+  );
+  const addressRef = useRef<string | null>(address);
   const [mapsLoaded, setMapsLoaded] = useState(false);
   const [mapsError, setMapsError] = useState<string>("");
 
@@ -143,8 +149,10 @@ export function TripTrackingModal({ trip, onClose }: TripTrackingModalProps) {
           leafletMapRef.current
         );
 
-        // Safe marker info
-        const popupContent = `
+        // Safe marker info (built on open so it picks up the latest address)
+        const popupContent = () => {
+          const markerPosition = safeMarkerRef.current!.getLatLng();
+          return `
           <div style="padding: 8px; min-width: 200px;">
             <h3 style="margin: 0 0 8px 0; color: #8B5CF6;">🚚 Safe ${
               safe?.serial_number
@@ -157,9 +165,13 @@ export function TripTrackingModal({ trip, onClose }: TripTrackingModalProps) {
               <p style="margin: 2px 0;"><strong>Status:</strong> ${
                 location.status
               }</p>
-              <p style="margin: 2px 0;"><strong>Location:</strong> ${position[0].toFixed(
-                6
-              )}, ${position[1].toFixed(6)}</p>
+              <p style="margin: 2px 0;"><strong>Location:</strong> ${
+                addressRef.current
+                  ? sanitizeText(addressRef.current)
+                  : `${markerPosition.lat.toFixed(
+                      6
+                    )}, ${markerPosition.lng.toFixed(6)}`
+              }</p>
               <p style="margin: 2px 0;"><strong>Accuracy:</strong> ±${
                 location.location.accuracy
               }m</p>
@@ -174,6 +186,7 @@ export function TripTrackingModal({ trip, onClose }: TripTrackingModalProps) {
             </div>
           </div>
         `;
+        };
 
         safeMarkerRef.current.bindPopup(popupContent);
 
@@ -217,6 +230,16 @@ export function TripTrackingModal({ trip, onClose }: TripTrackingModalProps) {
         };
         setLocation(newLocation);
         setLastUpdate(new Date());
+
+        // Resolve the address after the marker moves, without blocking it
+        safeAddressService
+          .resolve(trip.safe_id, result.location.lat, result.location.lng)
+          .then((resolved) => {
+            if (resolved) {
+              addressRef.current = resolved;
+              setAddress(resolved);
+            }
+          });
       } else {
         setLocation({
           status: "offline",
@@ -429,6 +452,12 @@ export function TripTrackingModal({ trip, onClose }: TripTrackingModalProps) {
                     </h3>
                   </div>
                   <div className="space-y-2 text-sm">
+                    {address && (
+                      <div>
+                        <span className="text-gray-500">Address:</span>
+                        <p className="text-gray-900">{address}</p>
+                      </div>
+                    )}
                     <div>
                       <span className="text-gray-500">Coordinates:</span>
                       <p className="font-mono text-xs">
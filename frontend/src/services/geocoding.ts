@@ -0,0 +1,119 @@
+interface ReverseGeocodeResponse {
+  features?: Array<{
+    properties: {
+      formatted?: string;
+    };
+  }>;
+}
+
+interface CachedAddress {
+  address: string | null;
+  expiresAt: number;
+}
+
+const CACHE_MAX_ENTRIES = 200;
+const CACHE_TTL_MS = 60 * 60 * 1000;
+// Failed lookups are remembered briefly so callers don't retry in a loop
+const FAILURE_TTL_MS = 2 * 60 * 1000;
+const REQUEST_TIMEOUT_MS = 8000;
+
+class GeocodingService {
+  // Dashboard tabs stay open for hours, so keep the cache bounded
+  private addressCache = new Map<string, CachedAddress>();
+  private pending = new Map<string, Promise<string | null>>();
+
+  reverseGeocode(lat: number, lng: number): Promise<string | null> {
+    const cacheKey = `${lat.toFixed(5)},${lng.toFixed(5)}`;
+
+    const cached = this.addressCache.get(cacheKey);
+    if (cached && cached.expiresAt > Date.now()) {
+      return Promise.resolve(cached.address);
+    }
+
+    const inFlight = this.pending.get(cacheKey);
+    if (inFlight) return inFlight;
+
+    const lookup = this.fetchAddress(lat, lng)
+      .then((address) => {
+        this.setCached(cacheKey, address);
+        return address;
+      })
+      .finally(() => {
+        this.pending.delete(cacheKey);
+      });
+
+    this.pending.set(cacheKey, lookup);
+    return lookup;
+  }
+
+  private setCached(cacheKey: string, address: string | null) {
+    // Re-insert so the oldest entry is first (Map preserves insertion order)
+    this.addressCache.delete(cacheKey);
+    if (this.addressCache.size >= CACHE_MAX_ENTRIES) {
+      const oldestKey = this.addressCache.keys().next().value;
+      if (oldestKey !== undefined) this.addressCache.delete(oldestKey);
+    }
+
+    this.addressCache.set(cacheKey, {
+      address,
+      expiresAt: Date.now() + (address ? CACHE_TTL_MS : FAILURE_TTL_MS),
+    });
+  }
+
+  private async fetchAddress(lat: number, lng: number): Promise<string | null> {
+    const controller = new AbortController();
+    const timeoutId = setTimeout(() => controller.abort(), REQUEST_TIMEOUT_MS);
+
+    try {
+      let data: ReverseGeocodeResponse;
+
+      if (import.meta.env.DEV) {
+        // Local development: Call Geoapify directly
+        const apiKey = import.meta.env.VITE_GEOAPIFY_API_KEY || "";
+        if (!apiKey) {
+          console.warn("VITE_GEOAPIFY_API_KEY not set for local development");
+          return null;
+        }
+
+        const url = new URL("https://api.geoapify.com/v1/geocode/reverse");
+        url.searchParams.set("lat", String(lat));
+        url.searchParams.set("lon", String(lng));
+        url.searchParams.set("apiKey", apiKey);
+        url.searchParams.set("limit", "1");
+        url.searchParams.set("lang", "en");
+
+        const response = await fetch(url.toString(), {
+          signal: controller.signal,
+        });
+        if (!response.ok) {
+          throw new Error("Geoapify API error");
+        }
+        data = await response.json();
+      } else {
+        // Production: Use serverless function so the key stays server-side
+        const response = await fetch("/api/geocode", {
+          method: "POST",
+          headers: {
+            "Content-Type": "application/json",
+          },
+          body: JSON.stringify({ lat, lng }),
+          signal: controller.signal,
+        });
+
+        if (!response.ok) {
+          throw new Error("Failed to resolve address");
+        }
+        data = await response.json();
+      }
+
+      return data.features?.[0]?.properties.formatted || null;
+    } catch (error) {
+      console.error("Reverse geocoding failed:", error);
+      return null;
+    } finally {
+      clearTimeout(timeoutId);
+    }
+  }
+}
+
+export const geocodingService = new GeocodingService();
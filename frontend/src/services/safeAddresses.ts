@@ -0,0 +1,86 @@
+import { geocodingService } from "./geocoding";
+
+interface ResolvedAddress {
+  lat: number;
+  lng: number;
+  address: string | null;
+  checkedAt: number;
+  failed: boolean;
+}
+
+// Only re-resolve once a safe has moved this far and the interval has passed
+const MIN_DISTANCE_METERS = 50;
+const MIN_INTERVAL_MS = 60 * 1000;
+// Back off after a failed lookup instead of retrying on every poll
+const FAILURE_RETRY_MS = 2 * 60 * 1000;
+
+function distanceMeters(
+  lat1: number,
+  lng1: number,
+  lat2: number,
+  lng2: number
+): number {
+  const toRad = (deg: number) => (deg * Math.PI) / 180;
+  const dLat = toRad(lat2 - lat1);
+  const dLng = toRad(lng2 - lng1);
+  const a =
+    Math.sin(dLat / 2) ** 2 +
+    Math.cos(toRad(lat1)) * Math.cos(toRad(lat2)) * Math.sin(dLng / 2) ** 2;
+  return 6371000 * 2 * Math.atan2(Math.sqrt(a), Math.sqrt(1 - a));
+}
+
+// Throttles reverse geocoding of tracked safe positions, which are polled
+// every 15-30s, so each safe costs at most one lookup per interval.
+class SafeAddressService {
+  private lastResolved = new Map<string, ResolvedAddress>();
+  private pending = new Map<string, Promise<string | null>>();
+
+  getLastAddress(safeId: string): string | null {
+    return this.lastResolved.get(safeId)?.address ?? null;
+  }
+
+  resolve(safeId: string, lat: number, lng: number): Promise<string | null> {
+    // A manual refresh can overlap a poll; share the lookup already running
+    const inFlight = this.pending.get(safeId);
+    if (inFlight) return inFlight;
+
+    const previous = this.lastResolved.get(safeId);
+    if (previous) {
+      const elapsed = Date.now() - previous.checkedAt;
+      if (previous.failed && elapsed < FAILURE_RETRY_MS) {
+        return Promise.resolve(previous.address);
+      }
+      if (
+        !previous.failed &&
+        (elapsed < MIN_INTERVAL_MS ||
+          distanceMeters(previous.lat, previous.lng, lat, lng) <
+            MIN_DISTANCE_METERS)
+      ) {
+        return Promise.resolve(previous.address);
+      }
+    }
+
+    const lookup = geocodingService
+      .reverseGeocode(lat, lng)
+      .then((address) => {
+        // Keep showing the last known address if this lookup failed
+        const resolved = address ?? previous?.address ?? null;
+        this.lastResolved.set(safeId, {
+          lat,
+          lng,
+          address: resolved,
+          checkedAt: Date.now(),
+          failed: !address,
+        });
+        return resolved;
+      })
+      .finally(() => {
+        this.pending.delete(safeId);
+      });
+
+    this.pending.set(safeId, lookup);
+    return lookup;
+  }
+}
+
+export const safeAddressService = new SafeAddressService();